@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package dev
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+)
+
+// API exposes the dev_* JSON-RPC namespace used by integration tests to
+// script execution-client behavior without running a validator set.
+type API struct {
+	beacon *SimulatedBeacon
+}
+
+// NewAPI wraps beacon's dev-mode RPCs for registration on the node's RPC
+// server under the "dev" namespace.
+func NewAPI(beacon *SimulatedBeacon) *API {
+	return &API{beacon: beacon}
+}
+
+// AddWithdrawal queues a withdrawal to be included in the next block this
+// SimulatedBeacon seals. Backs the dev_addWithdrawal RPC.
+func (a *API) AddWithdrawal(w *engineprimitives.Withdrawal) {
+	a.beacon.AddWithdrawal(w)
+}
+
+// SetFeeRecipient changes the fee recipient used for blocks sealed from
+// now on. Backs the dev_setFeeRecipient RPC.
+func (a *API) SetFeeRecipient(addr common.Address) {
+	a.beacon.SetFeeRecipient(addr)
+}
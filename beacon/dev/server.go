@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package dev
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Server is the `--dev` flag's entry point: it registers API under the
+// "dev" JSON-RPC namespace and drives beacon's block production loop for
+// as long as it runs.
+type Server struct {
+	beacon     *SimulatedBeacon
+	httpServer *http.Server
+}
+
+// NewServer creates a Server listening on addr that drives beacon and
+// serves its dev_* RPCs.
+func NewServer(addr string, beacon *SimulatedBeacon) *Server {
+	rpcServer := rpc.NewServer()
+	_ = rpcServer.RegisterName("dev", NewAPI(beacon))
+
+	return &Server{
+		beacon:     beacon,
+		httpServer: &http.Server{Addr: addr, Handler: rpcServer},
+	}
+}
+
+// Start begins beacon's block production loop and serves until ctx is
+// cancelled or Stop is called, returning once the underlying http.Server
+// has shut down.
+func (s *Server) Start(ctx context.Context) error {
+	s.beacon.Start(ctx)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.httpServer.ListenAndServe() }()
+
+	select {
+	case <-ctx.Done():
+		return s.Stop(context.Background())
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Stop halts beacon's block production and gracefully shuts down the
+// HTTP server.
+func (s *Server) Stop(ctx context.Context) error {
+	s.beacon.Stop()
+	return s.httpServer.Shutdown(ctx)
+}
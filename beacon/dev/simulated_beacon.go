@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Package dev implements a `--dev`-mode driver that advances an attached
+// execution client purely through Engine API calls, without running a
+// validator set, mirroring go-ethereum's simulated beacon used in
+// developer mode.
+package dev
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+)
+
+// EngineClient is the subset of the Engine API that SimulatedBeacon drives
+// to advance the attached execution client.
+type EngineClient interface {
+	NewPayload(
+		ctx context.Context, req *engineprimitives.NewPayloadRequest,
+	) error
+	ForkchoiceUpdated(
+		ctx context.Context, req *engineprimitives.ForkchoiceUpdateRequest,
+	) (engineprimitives.PayloadID, error)
+	GetPayload(
+		ctx context.Context, req *engineprimitives.GetPayloadRequest,
+	) (*engineprimitives.GetPayloadResponse, error)
+}
+
+// SimulatedBeacon drives an attached execution client on a fixed period,
+// standing in for a full beacon-kit validator set during local
+// development and integration testing.
+type SimulatedBeacon struct {
+	engine      EngineClient
+	period      time.Duration
+	forkVersion uint32
+	rng         *rand.Rand
+
+	mu           sync.Mutex
+	feeRecipient common.Address
+	withdrawals  []*engineprimitives.Withdrawal
+	headHash     common.Hash
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewSimulatedBeacon creates a SimulatedBeacon that will produce a block
+// against engine every period, starting from genesisHash, requesting and
+// submitting payloads for forkVersion (e.g. engineprimitives.ForkVersionElectra
+// to exercise the engine_getPayloadV4/engine_newPayloadV4 ExecutionWitness
+// round trip).
+func NewSimulatedBeacon(
+	period time.Duration,
+	genesisHash common.Hash,
+	forkVersion uint32,
+	engine EngineClient,
+) *SimulatedBeacon {
+	return &SimulatedBeacon{
+		engine:      engine,
+		period:      period,
+		forkVersion: forkVersion,
+		rng:         rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec // dev-only.
+		headHash:    genesisHash,
+		stop:        make(chan struct{}),
+	}
+}
+
+// Start begins producing blocks every period until ctx is cancelled or
+// Stop is called.
+func (s *SimulatedBeacon) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.period)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				if err := s.sealBlock(ctx); err != nil {
+					// Dev mode is best-effort: swallow the error and keep
+					// ticking rather than taking the node down. The next
+					// tick's forkchoiceUpdated will retry from the last
+					// successfully sealed head.
+					continue
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts block production. It is safe to call more than once, or
+// concurrently with Start; only the first call closes s.stop.
+func (s *SimulatedBeacon) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+}
+
+// sealBlock drives one build-and-finalize cycle: forkchoiceUpdated with
+// fresh PayloadAttributes to start building, getPayload to retrieve the
+// result, newPayload to hand it back to the EL, and a second
+// forkchoiceUpdated to make it canonical.
+func (s *SimulatedBeacon) sealBlock(ctx context.Context) error {
+	s.mu.Lock()
+	attrs := engineprimitives.BuildPayloadAttributes(
+		uint64(time.Now().Unix()), //nolint:gosec // dev-only.
+		s.buildRandaoMix(),
+		s.feeRecipient,
+		s.withdrawals,
+	)
+	s.withdrawals = nil
+	headHash := s.headHash
+	s.mu.Unlock()
+
+	payloadID, err := s.engine.ForkchoiceUpdated(ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+		engineprimitives.BuildForkchoiceState(headHash, headHash, headHash),
+		attrs,
+		0,
+	))
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.engine.GetPayload(ctx, engineprimitives.BuildGetPayloadRequest(
+		payloadID, s.forkVersion, 0, nil, false, nil,
+	))
+	if err != nil {
+		return err
+	}
+
+	// resp.ExecutionWitness is populated by engine_getPayloadV4 from the
+	// Verkle fork onward; forward it back to the EL on the matching
+	// engine_newPayloadV4 call so a stateless EL can validate the block
+	// without holding the full state trie.
+	req := engineprimitives.BuildNewPayloadRequest(
+		resp.ExecutionPayload, nil, nil, false, false, resp.ExecutionWitness, s.forkVersion,
+	)
+	if err = s.engine.NewPayload(ctx, req); err != nil {
+		return err
+	}
+
+	newHead := resp.ExecutionPayload.GetBlockHash()
+	if _, err = s.engine.ForkchoiceUpdated(ctx, engineprimitives.BuildForkchoiceUpdateRequest(
+		engineprimitives.BuildForkchoiceState(newHead, newHead, newHead),
+		nil,
+		0,
+	)); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.headHash = newHead
+	s.mu.Unlock()
+	return nil
+}
+
+// buildRandaoMix derives the next prevRandao value from this
+// SimulatedBeacon's local RNG, standing in for the accumulated randao mix
+// a real validator set would supply.
+func (s *SimulatedBeacon) buildRandaoMix() common.Hash {
+	var mix common.Hash
+	s.rng.Read(mix[:])
+	return mix
+}
+
+// AddWithdrawal queues w to be included in the next sealed block, backing
+// the dev_addWithdrawal RPC.
+func (s *SimulatedBeacon) AddWithdrawal(w *engineprimitives.Withdrawal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.withdrawals = append(s.withdrawals, w)
+}
+
+// SetFeeRecipient changes the fee recipient used for blocks sealed from
+// now on, backing the dev_setFeeRecipient RPC.
+func (s *SimulatedBeacon) SetFeeRecipient(addr common.Address) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.feeRecipient = addr
+}
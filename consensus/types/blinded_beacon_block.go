@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	ctypes "github.com/berachain/beacon-kit/consensus-types/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+)
+
+// BlindedBeaconBlock is a BeaconBlock whose body carries only an
+// ExecutionPayloadHeader rather than the full ExecutionPayload, signed by
+// the proposer and submitted to a builder for reassembly, as per the
+// Ethereum Builder API's blinded-block flow.
+//
+// https://ethereum.github.io/builder-specs/#/Builder/submitBlindedBlock
+type BlindedBeaconBlock struct {
+	// Header is the beacon block header this blinded block commits to.
+	Header *ctypes.BeaconBlockHeader
+
+	// PayloadHeader is the header of the execution payload withheld by
+	// the builder until the signed block is submitted back to it.
+	PayloadHeader engineprimitives.ExecutionPayloadHeader
+
+	// Signature is the proposer's BLS signature over Header, computed
+	// under DomainTypeApplicationBuilder.
+	Signature [96]byte
+}
+
+// NewBlindedBeaconBlock creates a new BlindedBeaconBlock.
+func NewBlindedBeaconBlock(
+	header *ctypes.BeaconBlockHeader,
+	payloadHeader engineprimitives.ExecutionPayloadHeader,
+) *BlindedBeaconBlock {
+	return &BlindedBeaconBlock{
+		Header:        header,
+		PayloadHeader: payloadHeader,
+	}
+}
+
+// GetHeader returns the beacon block header this blinded block commits to.
+func (b *BlindedBeaconBlock) GetHeader() *ctypes.BeaconBlockHeader {
+	return b.Header
+}
+
+// GetPayloadHeader returns the withheld execution payload's header.
+func (b *BlindedBeaconBlock) GetPayloadHeader() engineprimitives.ExecutionPayloadHeader {
+	return b.PayloadHeader
+}
+
+// SetSignature sets the proposer signature over Header.
+func (b *BlindedBeaconBlock) SetSignature(sig [96]byte) {
+	b.Signature = sig
+}
+
+// SigningRoot computes the root b's proposer signs under fd's
+// DomainTypeApplicationBuilder domain: the signing root binding
+// Header's hash tree root to that domain, as per the Ethereum Builder
+// API's blinded-block flow.
+//
+// https://github.com/ethereum/builder-specs/blob/main/specs/bellatrix/builder.md#signing
+func (b *BlindedBeaconBlock) SigningRoot(
+	fd *primitives.ForkData,
+) (primitives.Root, error) {
+	headerRoot, err := b.Header.HashTreeRoot()
+	if err != nil {
+		return primitives.Root{}, err
+	}
+	return primitives.ComputeSigningRoot(
+		fd, primitives.DomainTypeApplicationBuilder, headerRoot,
+	)
+}
+
+// MarshalSSZ encodes b as the concatenation of its header's, payload
+// header's, and signature's own SSZ encodings, in that order. Every
+// field is fixed-size, so no offset table is required. This satisfies
+// builder.SignedBlindedBeaconBlock, letting b be submitted directly via
+// builder.BlockBuilder.SubmitBlindedBlock.
+func (b *BlindedBeaconBlock) MarshalSSZ() ([]byte, error) {
+	headerBytes, err := b.Header.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	payloadHeaderBytes, err := b.PayloadHeader.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, len(headerBytes)+len(payloadHeaderBytes)+len(b.Signature))
+	buf = append(buf, headerBytes...)
+	buf = append(buf, payloadHeaderBytes...)
+	buf = append(buf, b.Signature[:]...)
+	return buf, nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Package builder provides a pluggable client for the Ethereum Builder
+// API (MEV-boost), letting beacon-kit source block bodies from an
+// external builder instead of (or in a race against) its own
+// execution client.
+package builder
+
+import (
+	"context"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlockBuilder is implemented by anything that can source a bid and
+// reassemble a full payload for an external block builder, as per the
+// Ethereum Builder API.
+//
+// https://ethereum.github.io/builder-specs/
+type BlockBuilder interface {
+	// GetHeader requests a signed bid for the given slot/parentHash/
+	// validator pubkey, returning the withheld payload's header and its
+	// declared value.
+	GetHeader(
+		ctx context.Context,
+		slot uint64,
+		parentHash common.Hash,
+		pubkey []byte,
+	) (*Bid, error)
+
+	// SubmitBlindedBlock submits a signed BlindedBeaconBlock to the
+	// builder and returns the SSZ-encoded execution payload it withheld,
+	// for the caller to decode with the codec matching the block's fork
+	// version.
+	SubmitBlindedBlock(
+		ctx context.Context,
+		signedBlock SignedBlindedBeaconBlock,
+	) ([]byte, error)
+}
+
+// SignedBlindedBeaconBlock is the subset of a signed BlindedBeaconBlock
+// the builder package needs in order to submit it, kept narrow so this
+// package does not need to depend on consensus/types.
+type SignedBlindedBeaconBlock interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// Bid is a builder's signed response to GetHeader: the header of the
+// payload it is willing to reveal, and the value it claims that payload
+// is worth to the proposer.
+type Bid struct {
+	// Header is the header of the withheld execution payload.
+	Header engineprimitives.ExecutionPayloadHeader
+	// Value is the value, in Wei, the builder claims this bid is worth.
+	Value *big.Int
+	// Pubkey is the builder's BLS public key, used to verify Signature.
+	Pubkey []byte
+	// Signature is the builder's signature over Header and Value.
+	Signature [96]byte
+}
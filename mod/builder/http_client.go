@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	enginecommon "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// headerJSON is the wire representation of a builder bid's header, the
+// concrete type decoded from JSON before being exposed through the
+// engineprimitives.ExecutionPayloadHeader interface on Bid.
+type headerJSON struct {
+	ParentHash       enginecommon.ExecutionHash    `json:"parent_hash"`
+	FeeRecipient     enginecommon.ExecutionAddress `json:"fee_recipient"`
+	StateRoot        enginecommon.Root             `json:"state_root"`
+	ReceiptsRoot     enginecommon.Root             `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes                 `json:"logs_bloom"`
+	PrevRandao       enginecommon.Bytes32          `json:"prev_randao"`
+	BlockHash        enginecommon.ExecutionHash    `json:"block_hash"`
+	TransactionsRoot enginecommon.Root             `json:"transactions_root"`
+	WithdrawalsRoot  enginecommon.Root             `json:"withdrawals_root"`
+}
+
+func (h *headerJSON) GetParentHash() enginecommon.ExecutionHash      { return h.ParentHash }
+func (h *headerJSON) GetFeeRecipient() enginecommon.ExecutionAddress { return h.FeeRecipient }
+func (h *headerJSON) GetStateRoot() enginecommon.Root                { return h.StateRoot }
+func (h *headerJSON) GetReceiptsRoot() enginecommon.Root             { return h.ReceiptsRoot }
+func (h *headerJSON) GetLogsBloom() []byte                           { return h.LogsBloom }
+func (h *headerJSON) GetPrevRandao() enginecommon.Bytes32            { return h.PrevRandao }
+func (h *headerJSON) GetBlockHash() enginecommon.ExecutionHash       { return h.BlockHash }
+func (h *headerJSON) GetTransactionsRoot() enginecommon.Root         { return h.TransactionsRoot }
+func (h *headerJSON) GetWithdrawalsRoot() enginecommon.Root          { return h.WithdrawalsRoot }
+
+// logsBloomSize is the fixed size, in bytes, of an execution payload
+// header's logs bloom filter.
+const logsBloomSize = 256
+
+// ErrMalformedLogsBloom is returned when a decoded header's logs bloom is
+// not exactly logsBloomSize bytes, and so cannot be SSZ-encoded as the
+// fixed-size field the wire format requires.
+var ErrMalformedLogsBloom = errors.New("builder: logs bloom is not 256 bytes")
+
+// MarshalSSZ implements engineprimitives.ExecutionPayloadHeader by
+// concatenating h's fields in declaration order. Every field is
+// fixed-size, so the encoding needs no offset table.
+func (h *headerJSON) MarshalSSZ() ([]byte, error) {
+	if len(h.LogsBloom) != logsBloomSize {
+		return nil, ErrMalformedLogsBloom
+	}
+
+	buf := make([]byte, 0, 500) //nolint:mnd // sum of the fixed field sizes below.
+	buf = append(buf, h.ParentHash[:]...)
+	buf = append(buf, h.FeeRecipient[:]...)
+	buf = append(buf, h.StateRoot[:]...)
+	buf = append(buf, h.ReceiptsRoot[:]...)
+	buf = append(buf, h.LogsBloom...)
+	buf = append(buf, h.PrevRandao[:]...)
+	buf = append(buf, h.BlockHash[:]...)
+	buf = append(buf, h.TransactionsRoot[:]...)
+	buf = append(buf, h.WithdrawalsRoot[:]...)
+	return buf, nil
+}
+
+// bidJSON is the wire representation of a GetHeader response body. Pubkey
+// and Signature are decoded via hexutil.Bytes, matching every other byte
+// field on the wire: the Builder API hex-encodes them as "0x..." strings,
+// which neither a raw []byte (base64 by default) nor a [96]byte (a JSON
+// array of numbers by default) would decode correctly. Value arrives as a
+// quoted decimal string, which *big.Int cannot decode from JSON directly,
+// so it is parsed after decoding.
+type bidJSON struct {
+	Header    headerJSON    `json:"header"`
+	Value     string        `json:"value"`
+	Pubkey    hexutil.Bytes `json:"pubkey"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// ErrMalformedBid is returned when a builder's response to GetHeader
+// cannot be decoded or is missing required fields.
+var ErrMalformedBid = errors.New("builder: malformed bid")
+
+// HTTPClient implements BlockBuilder against a relay speaking the
+// Ethereum Builder API over HTTP.
+//
+// https://ethereum.github.io/builder-specs/#/Builder
+type HTTPClient struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPClient creates an HTTPClient that talks to the builder relay at
+// endpoint (e.g. "https://relay.example.com"), using timeout as the
+// per-request deadline.
+func NewHTTPClient(endpoint string, timeout time.Duration) *HTTPClient {
+	return &HTTPClient{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// GetHeader implements BlockBuilder by calling
+// GET /eth/v1/builder/header/{slot}/{parent_hash}/{pubkey}.
+func (c *HTTPClient) GetHeader(
+	ctx context.Context,
+	slot uint64,
+	parentHash gethcommon.Hash,
+	pubkey []byte,
+) (*Bid, error) {
+	url := fmt.Sprintf(
+		"%s/eth/v1/builder/header/%d/%s/0x%s",
+		c.endpoint, slot, parentHash.Hex(), hex.EncodeToString(pubkey),
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrMalformedBid, resp.StatusCode)
+	}
+
+	var wire bidJSON
+	if err = json.NewDecoder(resp.Body).Decode(&wire); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedBid, err)
+	}
+	value, ok := new(big.Int).SetString(wire.Value, 10) //nolint:mnd // decimal.
+	if !ok || len(wire.Signature) != 96 {               //nolint:mnd // BLS signature length.
+		return nil, ErrMalformedBid
+	}
+
+	header := wire.Header
+	bid := &Bid{
+		Header: &header,
+		Value:  value,
+		Pubkey: wire.Pubkey,
+	}
+	copy(bid.Signature[:], wire.Signature)
+	return bid, nil
+}
+
+// SubmitBlindedBlock implements BlockBuilder by calling
+// POST /eth/v1/builder/blinded_blocks with the SSZ-encoded signed block.
+// It returns the SSZ-encoded execution payload the relay withheld; the
+// caller is responsible for decoding it with the codec for the block's
+// fork version, which this package does not know about.
+func (c *HTTPClient) SubmitBlindedBlock(
+	ctx context.Context,
+	signedBlock SignedBlindedBeaconBlock,
+) ([]byte, error) {
+	body, err := signedBlock.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.endpoint + "/eth/v1/builder/blinded_blocks"
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrMalformedBid, resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package builder_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/builder"
+)
+
+func bidBody() string {
+	hash := "0x" + strings.Repeat("11", 32)      //nolint:mnd // 32-byte hash.
+	addr := "0x" + strings.Repeat("22", 20)      //nolint:mnd // 20-byte address.
+	root := "0x" + strings.Repeat("33", 32)      //nolint:mnd // 32-byte root.
+	bloom := "0x" + strings.Repeat("00", 256)    //nolint:mnd // 256-byte logs bloom.
+	pubkey := "0x" + strings.Repeat("44", 48)    //nolint:mnd // 48-byte BLS pubkey.
+	signature := "0x" + strings.Repeat("55", 96) //nolint:mnd // 96-byte BLS signature.
+
+	return fmt.Sprintf(`{
+		"header": {
+			"parent_hash": %q, "fee_recipient": %q, "state_root": %q,
+			"receipts_root": %q, "logs_bloom": %q, "prev_randao": %q,
+			"block_hash": %q, "transactions_root": %q, "withdrawals_root": %q
+		},
+		"value": "1000",
+		"pubkey": %q,
+		"signature": %q
+	}`, hash, addr, root, root, bloom, root, hash, root, root, pubkey, signature)
+}
+
+func TestHTTPClientGetHeaderDecodesHexFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(bidBody()))
+	}))
+	defer srv.Close()
+
+	client := builder.NewHTTPClient(srv.URL, time.Second)
+	bid, err := client.GetHeader(context.Background(), 1, [32]byte{}, []byte{0x44})
+	if err != nil {
+		t.Fatalf("GetHeader: %v", err)
+	}
+
+	if len(bid.Pubkey) != 48 { //nolint:mnd // BLS pubkey length.
+		t.Fatalf("expected a 48-byte decoded pubkey, got %d bytes", len(bid.Pubkey))
+	}
+	if bid.Signature[0] != 0x55 || bid.Signature[95] != 0x55 {
+		t.Fatalf("expected the signature to decode from hex, got %x", bid.Signature)
+	}
+	if bid.Value == nil || bid.Value.String() != "1000" {
+		t.Fatalf("expected a value of 1000, got %v", bid.Value)
+	}
+}
+
+func TestHTTPClientGetHeaderRejectsShortSignature(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"header":{},"value":"1","pubkey":"0x44","signature":"0x55"}`))
+	}))
+	defer srv.Close()
+
+	client := builder.NewHTTPClient(srv.URL, time.Second)
+	if _, err := client.GetHeader(context.Background(), 1, [32]byte{}, nil); err == nil {
+		t.Fatal("expected a short signature to be rejected as a malformed bid")
+	}
+}
+
+func TestHTTPClientGetHeaderRejectsNonOKStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := builder.NewHTTPClient(srv.URL, time.Second)
+	if _, err := client.GetHeader(context.Background(), 1, [32]byte{}, nil); err == nil {
+		t.Fatal("expected a non-200 response to be rejected")
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package builder
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RaceBids requests a bid from builder for the given slot/parentHash/
+// pubkeys and reports whether it should be preferred over a payload built
+// locally for localValue, using the same weighted-bid comparison as
+// engine_getPayloadV3: the builder wins when
+// `bid.Value * boostFactor >= localValue * 100`, with a boostFactor of 0
+// treated as 100, as PrefersBuilder describes.
+//
+// It returns a nil Bid, with no error, whenever no builder pubkey yields a
+// usable bid — callers should fall back to the local payload in that case
+// exactly as they would on a builder timeout.
+func RaceBids(
+	ctx context.Context,
+	b BlockBuilder,
+	slot uint64,
+	parentHash common.Hash,
+	pubkeys [][]byte,
+	localValue *big.Int,
+	boostFactor uint64,
+) (*Bid, error) {
+	var best *Bid
+	for _, pubkey := range pubkeys {
+		bid, err := b.GetHeader(ctx, slot, parentHash, pubkey)
+		if err != nil || bid == nil || bid.Value == nil {
+			continue
+		}
+		if best == nil || bid.Value.Cmp(best.Value) > 0 {
+			best = bid
+		}
+	}
+	if best == nil || !PrefersBuilder(localValue, best.Value, boostFactor) {
+		return nil, nil
+	}
+	return best, nil
+}
+
+// PrefersBuilder reports whether a builder bid of builderValue should be
+// preferred over a locally-built payload worth localValue, per the
+// engine_getPayloadV3 weighted-bid comparison:
+//
+//	builderValue * boostFactor >= localValue * 100
+//
+// A boostFactor of 0 is treated as 100, so the two values are then
+// compared at face value.
+//
+// This is the single source of truth for that comparison; RaceBids uses
+// it internally, and callers that already have both values in hand (e.g.
+// the state processor's block-production path) may call it directly
+// instead of going through RaceBids again.
+func PrefersBuilder(localValue, builderValue *big.Int, boostFactor uint64) bool {
+	if boostFactor == 0 {
+		boostFactor = 100 //nolint:mnd // face-value comparison.
+	}
+	weightedLocal := new(big.Int).Mul(localValue, big.NewInt(100)) //nolint:mnd // percentage base.
+	weightedBuilder := new(big.Int).Mul(
+		builderValue, new(big.Int).SetUint64(boostFactor),
+	)
+	return weightedBuilder.Cmp(weightedLocal) >= 0
+}
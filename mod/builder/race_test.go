@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package builder_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/builder"
+)
+
+func TestPrefersBuilder(t *testing.T) {
+	local := big.NewInt(100)
+
+	if !builder.PrefersBuilder(local, big.NewInt(100), 100) {
+		t.Fatal("expected an equal builder bid at face value to be preferred")
+	}
+	if builder.PrefersBuilder(local, big.NewInt(99), 100) {
+		t.Fatal("expected a lower builder bid at face value to lose")
+	}
+	if !builder.PrefersBuilder(local, big.NewInt(50), 200) {
+		t.Fatal("expected a 2x boost factor to double the builder bid's weight")
+	}
+	if builder.PrefersBuilder(local, big.NewInt(50), 199) {
+		t.Fatal("expected a boost factor just under 2x to still lose to local")
+	}
+}
+
+func TestPrefersBuilderZeroBoostFactorDefaultsToFaceValue(t *testing.T) {
+	local := big.NewInt(100)
+
+	if !builder.PrefersBuilder(local, big.NewInt(100), 0) {
+		t.Fatal("expected a zero boost factor to default to a face-value comparison")
+	}
+	if builder.PrefersBuilder(local, big.NewInt(99), 0) {
+		t.Fatal("expected a zero boost factor to still reject a lower builder bid")
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package builder
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+)
+
+// BlockSigner signs a signing root with the proposer's BLS key, returning
+// the resulting 96-byte signature.
+type BlockSigner interface {
+	Sign(signingRoot []byte) ([96]byte, error)
+}
+
+// PayloadDecoder decodes the SSZ-encoded execution payload a builder
+// returns from BlockBuilder.SubmitBlindedBlock, using the codec for the
+// block's fork version, which this package does not know about.
+type PayloadDecoder func(data []byte) (engineprimitives.ExecutionPayload, error)
+
+// SignableBlindedBlock is a SignedBlindedBeaconBlock that can also compute
+// its own signing root and accept a signature, letting
+// SignAndSubmitBlindedBlock sign and submit it without this package
+// depending on consensus/types for the concrete block type.
+type SignableBlindedBlock interface {
+	SignedBlindedBeaconBlock
+
+	// SigningRoot returns the root the proposer signs under fd's
+	// DomainTypeApplicationBuilder domain.
+	SigningRoot(fd *primitives.ForkData) (primitives.Root, error)
+	// SetSignature sets the proposer's signature over the root SigningRoot
+	// returns.
+	SetSignature(sig [96]byte)
+}
+
+// SignAndSubmitBlindedBlock signs block under fd's
+// DomainTypeApplicationBuilder domain using signer, submits it to b, and
+// decodes the withheld payload b returns with decode, as per the Ethereum
+// Builder API's blinded-block flow.
+//
+// https://ethereum.github.io/builder-specs/#/Builder/submitBlindedBlock
+//
+// Submission is best-effort: a failure to compute the signing root, sign,
+// submit, or decode the response falls back silently to a nil payload
+// with no error, exactly as RaceBids falls back on a builder timeout or
+// malformed bid. Callers should use their own locally-built payload in
+// that case.
+func SignAndSubmitBlindedBlock(
+	ctx context.Context,
+	b BlockBuilder,
+	signer BlockSigner,
+	fd *primitives.ForkData,
+	block SignableBlindedBlock,
+	decode PayloadDecoder,
+) (engineprimitives.ExecutionPayload, error) {
+	signingRoot, err := block.SigningRoot(fd)
+	if err != nil {
+		return nil, nil //nolint:nilerr // best-effort: caller falls back to its local payload.
+	}
+
+	sig, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, nil //nolint:nilerr // best-effort: caller falls back to its local payload.
+	}
+	block.SetSignature(sig)
+
+	raw, err := b.SubmitBlindedBlock(ctx, block)
+	if err != nil {
+		return nil, nil //nolint:nilerr // best-effort: caller falls back to its local payload.
+	}
+
+	payload, err := decode(raw)
+	if err != nil {
+		return nil, nil //nolint:nilerr // best-effort: caller falls back to its local payload.
+	}
+	return payload, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package lightclient
+
+import "context"
+
+// Gossip topic names, as per the Altair light-client gossip spec.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_finality_update
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/p2p-interface.md#light_client_optimistic_update
+const (
+	GossipTopicFinalityUpdate   = "light_client_finality_update"
+	GossipTopicOptimisticUpdate = "light_client_optimistic_update"
+)
+
+// Gossiper publishes an SSZ-encoded message onto a named gossip topic. It is
+// satisfied by the node's p2p pubsub layer.
+type Gossiper interface {
+	Publish(ctx context.Context, topic string, data []byte) error
+}
+
+// sszMarshaler is implemented by the sszgen-generated code for
+// LightClientFinalityUpdate and LightClientOptimisticUpdate.
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// Service forwards light-client updates onto the gossip network, using Pool
+// to ensure a stale or non-improving update is never forwarded twice.
+type Service struct {
+	pool   *Pool
+	gossip Gossiper
+}
+
+// NewService creates a Service that forwards updates accepted by pool onto
+// gossip.
+func NewService(pool *Pool, gossip Gossiper) *Service {
+	return &Service{pool: pool, gossip: gossip}
+}
+
+// ForwardFinalityUpdate gossips update on GossipTopicFinalityUpdate if it
+// improves on the last finality update this Service has forwarded.
+func (s *Service) ForwardFinalityUpdate(
+	ctx context.Context, update *LightClientFinalityUpdate,
+) error {
+	if !s.pool.ShouldForwardFinalityUpdate(update) {
+		return nil
+	}
+	return s.publish(ctx, GossipTopicFinalityUpdate, update)
+}
+
+// ForwardOptimisticUpdate gossips update on GossipTopicOptimisticUpdate if
+// it improves on the last optimistic update this Service has forwarded.
+func (s *Service) ForwardOptimisticUpdate(
+	ctx context.Context, update *LightClientOptimisticUpdate,
+) error {
+	if !s.pool.ShouldForwardOptimisticUpdate(update) {
+		return nil
+	}
+	return s.publish(ctx, GossipTopicOptimisticUpdate, update)
+}
+
+func (s *Service) publish(
+	ctx context.Context, topic string, update sszMarshaler,
+) error {
+	data, err := update.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	return s.gossip.Publish(ctx, topic, data)
+}
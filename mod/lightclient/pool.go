@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package lightclient
+
+import (
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+)
+
+// Pool tracks the most recently forwarded light-client updates so that
+// beacon-kit never gossips a finality or optimistic update that does not
+// improve on what it has already sent, mirroring Nimbus's light-client
+// forwarding rules.
+type Pool struct {
+	mu sync.Mutex
+
+	latestForwardedFinalitySlot   primitives.Slot
+	latestForwardedOptimisticSlot primitives.Slot
+
+	latestFinalityUpdate   *LightClientFinalityUpdate
+	latestOptimisticUpdate *LightClientOptimisticUpdate
+}
+
+// NewPool creates an empty Pool.
+func NewPool() *Pool {
+	return &Pool{}
+}
+
+// LatestFinalitySlot returns the finalized-header slot of the last
+// finality update forwarded from this Pool.
+func (p *Pool) LatestFinalitySlot() primitives.Slot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.latestForwardedFinalitySlot
+}
+
+// LatestOptimisticSlot returns the attested-header slot of the last
+// optimistic update forwarded from this Pool.
+func (p *Pool) LatestOptimisticSlot() primitives.Slot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.latestForwardedOptimisticSlot
+}
+
+// ShouldForwardFinalityUpdate reports whether update improves on the last
+// finality update forwarded from this Pool, and if so records it as the
+// new high-water mark.
+func (p *Pool) ShouldForwardFinalityUpdate(
+	update *LightClientFinalityUpdate,
+) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if update.FinalizedHeader.Slot <= p.latestForwardedFinalitySlot {
+		return false
+	}
+	p.latestForwardedFinalitySlot = update.FinalizedHeader.Slot
+	p.latestFinalityUpdate = update
+	return true
+}
+
+// LatestFinalityUpdate returns the last finality update forwarded from this
+// Pool, or nil if none has been forwarded yet. It backs the
+// /eth/v1/beacon/light_client/finality_update REST endpoint.
+func (p *Pool) LatestFinalityUpdate() *LightClientFinalityUpdate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.latestFinalityUpdate
+}
+
+// ShouldForwardOptimisticUpdate reports whether update improves on the
+// last optimistic update forwarded from this Pool, and if so records it
+// as the new high-water mark.
+func (p *Pool) ShouldForwardOptimisticUpdate(
+	update *LightClientOptimisticUpdate,
+) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if update.AttestedHeader.Slot <= p.latestForwardedOptimisticSlot {
+		return false
+	}
+	p.latestForwardedOptimisticSlot = update.AttestedHeader.Slot
+	p.latestOptimisticUpdate = update
+	return true
+}
+
+// LatestOptimisticUpdate returns the last optimistic update forwarded from
+// this Pool, or nil if none has been forwarded yet. It backs the
+// /eth/v1/beacon/light_client/optimistic_update REST endpoint.
+func (p *Pool) LatestOptimisticUpdate() *LightClientOptimisticUpdate {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.latestOptimisticUpdate
+}
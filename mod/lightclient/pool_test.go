@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package lightclient_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/lightclient"
+)
+
+func TestPoolShouldForwardFinalityUpdate(t *testing.T) {
+	pool := lightclient.NewPool()
+
+	first := &lightclient.LightClientFinalityUpdate{
+		FinalizedHeader: lightclient.LightClientHeader{Slot: 10},
+	}
+	if !pool.ShouldForwardFinalityUpdate(first) {
+		t.Fatal("expected the first finality update to be forwarded")
+	}
+	if pool.LatestFinalitySlot() != 10 {
+		t.Fatalf("expected latest finality slot 10, got %d", pool.LatestFinalitySlot())
+	}
+	if pool.LatestFinalityUpdate() != first {
+		t.Fatal("expected LatestFinalityUpdate to return the forwarded update")
+	}
+
+	stale := &lightclient.LightClientFinalityUpdate{
+		FinalizedHeader: lightclient.LightClientHeader{Slot: 10},
+	}
+	if pool.ShouldForwardFinalityUpdate(stale) {
+		t.Fatal("expected a non-improving finality update to not be forwarded")
+	}
+
+	newer := &lightclient.LightClientFinalityUpdate{
+		FinalizedHeader: lightclient.LightClientHeader{Slot: 11},
+	}
+	if !pool.ShouldForwardFinalityUpdate(newer) {
+		t.Fatal("expected a newer finality update to be forwarded")
+	}
+	if pool.LatestFinalityUpdate() != newer {
+		t.Fatal("expected LatestFinalityUpdate to return the newer update")
+	}
+}
+
+func TestPoolShouldForwardOptimisticUpdate(t *testing.T) {
+	pool := lightclient.NewPool()
+
+	first := &lightclient.LightClientOptimisticUpdate{
+		AttestedHeader: lightclient.LightClientHeader{Slot: 10},
+	}
+	if !pool.ShouldForwardOptimisticUpdate(first) {
+		t.Fatal("expected the first optimistic update to be forwarded")
+	}
+	if pool.LatestOptimisticSlot() != 10 {
+		t.Fatalf("expected latest optimistic slot 10, got %d", pool.LatestOptimisticSlot())
+	}
+
+	stale := &lightclient.LightClientOptimisticUpdate{
+		AttestedHeader: lightclient.LightClientHeader{Slot: 9},
+	}
+	if pool.ShouldForwardOptimisticUpdate(stale) {
+		t.Fatal("expected an older optimistic update to not be forwarded")
+	}
+
+	newer := &lightclient.LightClientOptimisticUpdate{
+		AttestedHeader: lightclient.LightClientHeader{Slot: 11},
+	}
+	if !pool.ShouldForwardOptimisticUpdate(newer) {
+		t.Fatal("expected a newer optimistic update to be forwarded")
+	}
+	if pool.LatestOptimisticUpdate() != newer {
+		t.Fatal("expected LatestOptimisticUpdate to return the newer update")
+	}
+}
+
+func TestPoolLatestUpdatesNilBeforeAnyForward(t *testing.T) {
+	pool := lightclient.NewPool()
+	if pool.LatestFinalityUpdate() != nil {
+		t.Fatal("expected a nil finality update before anything is forwarded")
+	}
+	if pool.LatestOptimisticUpdate() != nil {
+		t.Fatal("expected a nil optimistic update before anything is forwarded")
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package lightclient
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// RegisterHandlers mounts the light-client REST endpoints on mux, serving
+// the latest updates held in pool.
+//
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientFinalityUpdate
+// https://ethereum.github.io/beacon-APIs/#/Beacon/getLightClientOptimisticUpdate
+func RegisterHandlers(mux *http.ServeMux, pool *Pool) {
+	mux.HandleFunc(
+		"/eth/v1/beacon/light_client/finality_update",
+		finalityUpdateHandler(pool),
+	)
+	mux.HandleFunc(
+		"/eth/v1/beacon/light_client/optimistic_update",
+		optimisticUpdateHandler(pool),
+	)
+}
+
+func finalityUpdateHandler(pool *Pool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		update := pool.LatestFinalityUpdate()
+		if update == nil {
+			http.Error(w, "no finality update available", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, update)
+	}
+}
+
+func optimisticUpdateHandler(pool *Pool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		update := pool.LatestOptimisticUpdate()
+		if update == nil {
+			http.Error(w, "no optimistic update available", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, update)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
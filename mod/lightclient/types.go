@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+// Package lightclient produces and validates the sync-committee-signed
+// updates that let light clients such as go-ethereum's blsync follow a
+// beacon-kit chain without executing the full state transition.
+package lightclient
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives"
+)
+
+// LightClientHeader identifies the beacon block a light-client update
+// attests to by its slot and hash tree root. It does not carry the block's
+// full beacon block header or its execution payload header.
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path types.go -objs LightClientHeader,LightClientFinalityUpdate,LightClientOptimisticUpdate -include ./pkg/bytes -output types.ssz.go
+//
+//nolint:lll
+type LightClientHeader struct {
+	// Slot is the slot of the beacon block this header describes.
+	Slot primitives.Slot
+	// BeaconRoot is the hash tree root of the beacon block at Slot.
+	BeaconRoot primitives.Root `ssz-size:"32"`
+}
+
+// LightClientFinalityUpdate is produced once per beacon block import and
+// forwarded to light clients so they can update their view of finality.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#lightclientfinalityupdate
+type LightClientFinalityUpdate struct {
+	// AttestedHeader is the header of the block whose sync-committee
+	// signature is carried in SyncAggregateSignature.
+	AttestedHeader LightClientHeader
+	// FinalizedHeader is the header of the latest finalized block known
+	// to AttestedHeader's state.
+	FinalizedHeader LightClientHeader
+	// FinalityBranch is the Merkle proof that FinalizedHeader is the
+	// finalized checkpoint recorded in AttestedHeader's state.
+	FinalityBranch [][]byte `ssz-size:"?,32" ssz-max:"6"`
+	// SyncAggregateSignature is the aggregate BLS signature of the sync
+	// committee over AttestedHeader's signing root.
+	SyncAggregateSignature [96]byte `ssz-size:"96"`
+	// SignatureSlot is the slot at which SyncAggregateSignature was
+	// produced, one slot after AttestedHeader.Slot.
+	SignatureSlot primitives.Slot
+}
+
+// LightClientOptimisticUpdate is produced once per beacon block import and
+// forwarded to light clients so they can optimistically track the head of
+// the chain ahead of finality.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/light-client/sync-protocol.md#lightclientoptimisticupdate
+type LightClientOptimisticUpdate struct {
+	// AttestedHeader is the header of the block whose sync-committee
+	// signature is carried in SyncAggregateSignature.
+	AttestedHeader LightClientHeader
+	// SyncAggregateSignature is the aggregate BLS signature of the sync
+	// committee over AttestedHeader's signing root.
+	SyncAggregateSignature [96]byte `ssz-size:"96"`
+	// SignatureSlot is the slot at which SyncAggregateSignature was
+	// produced, one slot after AttestedHeader.Slot.
+	SignatureSlot primitives.Slot
+}
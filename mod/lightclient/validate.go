@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package lightclient
+
+import (
+	"errors"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+)
+
+// MaxClockDisparity is the maximum amount of clock drift tolerated between
+// the wall-clock time implied by attestedHeader.Slot and the local clock
+// when validating an incoming light-client update.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/p2p-interface.md#clock-disparity
+const MaxClockDisparity = 500 * time.Millisecond
+
+var (
+	// ErrFinalityUpdateNotNewer is returned when a finality update does
+	// not improve on the last one forwarded.
+	ErrFinalityUpdateNotNewer = errors.New(
+		"light client: finalized header slot is not newer than the last forwarded update",
+	)
+	// ErrOptimisticUpdateNotNewer is returned when an optimistic update
+	// does not improve on the last one forwarded.
+	ErrOptimisticUpdateNotNewer = errors.New(
+		"light client: attested header slot is not newer than the last forwarded update",
+	)
+	// ErrAttestedHeaderTooFarInFuture is returned when an update's
+	// attested header slot starts more than MaxClockDisparity after wall
+	// time.
+	ErrAttestedHeaderTooFarInFuture = errors.New(
+		"light client: attested header slot is beyond the allowed clock disparity",
+	)
+	// ErrInvalidSyncCommitteeSignature is returned when the sync
+	// committee aggregate signature over an update fails verification.
+	ErrInvalidSyncCommitteeSignature = errors.New(
+		"light client: invalid sync committee aggregate signature",
+	)
+)
+
+// SignatureVerifier verifies a sync-committee aggregate BLS signature,
+// analogous to the per-validator Verifier used by state-transition's
+// randao processing.
+type SignatureVerifier interface {
+	VerifySignature(pubKey, signingRoot, signature []byte) error
+}
+
+// SlotToTime converts slot, given genesisTime and secondsPerSlot, to the
+// wall-clock time at which it begins.
+func SlotToTime(
+	slot, genesisTime primitives.Slot, secondsPerSlot uint64,
+) time.Time {
+	offset := uint64(slot) * secondsPerSlot
+	return time.Unix(int64(uint64(genesisTime)+offset), 0) //nolint:gosec // bounded by consensus parameters.
+}
+
+// ValidateFinalityUpdate rejects a LightClientFinalityUpdate whose
+// finalized header does not improve on pool's high-water mark, whose
+// attested header slot starts more than MaxClockDisparity after now, or whose
+// sync-committee aggregate signature fails verification under
+// aggregatePubKey and fd's DomainTypeSyncCommittee domain.
+func ValidateFinalityUpdate(
+	pool *Pool,
+	verifier SignatureVerifier,
+	update *LightClientFinalityUpdate,
+	aggregatePubKey []byte,
+	fd *primitives.ForkData,
+	now time.Time,
+	genesisTime primitives.Slot,
+	secondsPerSlot uint64,
+) error {
+	if update.FinalizedHeader.Slot <= pool.LatestFinalitySlot() {
+		return ErrFinalityUpdateNotNewer
+	}
+	if err := checkClockDisparity(
+		update.AttestedHeader.Slot, now, genesisTime, secondsPerSlot,
+	); err != nil {
+		return err
+	}
+	return verifySyncAggregate(
+		verifier, update.AttestedHeader, update.SyncAggregateSignature[:], aggregatePubKey, fd,
+	)
+}
+
+// ValidateOptimisticUpdate rejects a LightClientOptimisticUpdate whose
+// attested header does not improve on pool's high-water mark, starts more
+// than MaxClockDisparity after now, or whose sync-committee aggregate
+// signature fails verification under aggregatePubKey and fd's
+// DomainTypeSyncCommittee domain.
+func ValidateOptimisticUpdate(
+	pool *Pool,
+	verifier SignatureVerifier,
+	update *LightClientOptimisticUpdate,
+	aggregatePubKey []byte,
+	fd *primitives.ForkData,
+	now time.Time,
+	genesisTime primitives.Slot,
+	secondsPerSlot uint64,
+) error {
+	if update.AttestedHeader.Slot <= pool.LatestOptimisticSlot() {
+		return ErrOptimisticUpdateNotNewer
+	}
+	if err := checkClockDisparity(
+		update.AttestedHeader.Slot, now, genesisTime, secondsPerSlot,
+	); err != nil {
+		return err
+	}
+	return verifySyncAggregate(
+		verifier, update.AttestedHeader, update.SyncAggregateSignature[:], aggregatePubKey, fd,
+	)
+}
+
+func checkClockDisparity(
+	attestedSlot primitives.Slot,
+	now time.Time,
+	genesisTime primitives.Slot,
+	secondsPerSlot uint64,
+) error {
+	attestedTime := SlotToTime(attestedSlot, genesisTime, secondsPerSlot)
+	if attestedTime.Sub(now) > MaxClockDisparity {
+		return ErrAttestedHeaderTooFarInFuture
+	}
+	return nil
+}
+
+func verifySyncAggregate(
+	verifier SignatureVerifier,
+	header LightClientHeader,
+	signature []byte,
+	aggregatePubKey []byte,
+	fd *primitives.ForkData,
+) error {
+	signingRoot, err := ComputeSyncCommitteeSigningRoot(fd, header)
+	if err != nil {
+		return err
+	}
+	if err = verifier.VerifySignature(
+		aggregatePubKey, signingRoot[:], signature,
+	); err != nil {
+		return ErrInvalidSyncCommitteeSignature
+	}
+	return nil
+}
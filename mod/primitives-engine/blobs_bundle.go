@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package engineprimitives
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+
+// BlobsBundle is the bundle of blobs, KZG commitments, and KZG proofs
+// returned by the execution client alongside an ExecutionPayload, as per
+// the engine_getPayloadV3 response shape.
+//
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/cancun.md#blobsbundlev1
+type BlobsBundle struct {
+	// Commitments is the list of KZG commitments, one per blob.
+	Commitments []common.ExecutionHash
+	// Proofs is the list of KZG proofs, one per blob.
+	Proofs []common.ExecutionHash
+	// Blobs is the raw blob data backing the commitments and proofs.
+	Blobs [][]byte
+}
+
+// ExecutionPayloadHeader is the blinded counterpart of ExecutionPayload: it
+// carries commitments to the transactions and withdrawals rather than the
+// values themselves, allowing a builder to withhold the full payload until
+// the proposer has signed a blinded block around this header.
+//
+//nolint:lll
+type ExecutionPayloadHeader interface {
+	GetParentHash() common.ExecutionHash
+	GetFeeRecipient() common.ExecutionAddress
+	GetStateRoot() common.Root
+	GetReceiptsRoot() common.Root
+	GetLogsBloom() []byte
+	GetPrevRandao() common.Bytes32
+	GetBlockHash() common.ExecutionHash
+	GetTransactionsRoot() common.Root
+	GetWithdrawalsRoot() common.Root
+
+	// MarshalSSZ encodes the header as the fixed-size concatenation of its
+	// fields, in the field order above. Every field is fixed-size, so no
+	// offset table is required. A concrete implementation signs over (or
+	// is submitted alongside) this encoding wherever the Builder API calls
+	// for the header's SSZ form, e.g. inside a signed BlindedBeaconBlock.
+	MarshalSSZ() ([]byte, error)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package engineprimitives
+
+// Named ForkVersion values for the engine-API-facing forks this package
+// distinguishes behavior on. Everywhere else in this package ForkVersion
+// travels as a plain uint32 (e.g. on GetPayloadRequest); these constants
+// exist so call sites that care about a specific fork boundary don't have
+// to spell out a magic number.
+const (
+	// ForkVersionDeneb is the fork introducing blobs (engine_newPayloadV3 /
+	// engine_getPayloadV3), the last fork without an ExecutionWitness.
+	ForkVersionDeneb uint32 = 4
+	// ForkVersionElectra is the fork introducing Verkle state and the
+	// accompanying ExecutionWitness on engine_newPayloadV4.
+	ForkVersionElectra uint32 = 5
+)
+
+// IsVerkleEnabled reports whether forkVersion is at or after the fork that
+// introduced Verkle state, and therefore whether a NewPayloadRequest at that
+// fork is expected to carry an ExecutionWitness.
+func IsVerkleEnabled(forkVersion uint32) bool {
+	return forkVersion >= ForkVersionElectra
+}
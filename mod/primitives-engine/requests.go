@@ -26,6 +26,7 @@
 package engineprimitives
 
 import (
+	"math/big"
 	"unsafe"
 
 	"github.com/berachain/beacon-kit/mod/primitives"
@@ -51,6 +52,13 @@ type NewPayloadRequest struct {
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
+	// ExecutionWitness is the Verkle state-diff and proof accompanying
+	// ExecutionPayload from the Prague/Verkle fork onward. It is nil on
+	// every earlier fork and forwarded to the EL via engine_newPayloadV4.
+	ExecutionWitness *ExecutionWitness
+	// ForkVersion is the fork this payload is being submitted for, used to
+	// confirm ExecutionWitness is present only when IsVerkleEnabled(ForkVersion).
+	ForkVersion uint32
 }
 
 // BuildNewPayloadRequest builds a new payload request.
@@ -60,6 +68,8 @@ func BuildNewPayloadRequest(
 	parentBeaconBlockRoot *primitives.Root,
 	skipIfExists bool,
 	optimistic bool,
+	executionWitness *ExecutionWitness,
+	forkVersion uint32,
 ) *NewPayloadRequest {
 	return &NewPayloadRequest{
 		ExecutionPayload:      executionPayload,
@@ -67,6 +77,8 @@ func BuildNewPayloadRequest(
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
 		SkipIfExists:          skipIfExists,
 		Optimistic:            optimistic,
+		ExecutionWitness:      executionWitness,
+		ForkVersion:           forkVersion,
 	}
 }
 
@@ -105,7 +117,22 @@ func (n *NewPayloadRequest) HasValidVersionedAndBlockHashes() error {
 		n.VersionedHashes,
 		(*common.ExecutionHash)(n.ParentBeaconBlockRoot),
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Prague/Verkle (engine_newPayloadV4) payloads additionally carry an
+	// ExecutionWitness, which must be well-formed before it is forwarded
+	// to the execution client, and must be present on (and only on) forks
+	// that enable Verkle state.
+	witnessExpected := IsVerkleEnabled(n.ForkVersion)
+	if (n.ExecutionWitness != nil) != witnessExpected {
+		return ErrExecutionWitnessForkMismatch
+	}
+	if n.ExecutionWitness != nil {
+		return n.ExecutionWitness.Validate()
+	}
+	return nil
 }
 
 // ForkchoiceUpdateRequest.
@@ -133,21 +160,85 @@ func BuildForkchoiceUpdateRequest(
 }
 
 // GetPayloadRequest represents a request to get a payload.
+//
+//nolint:lll
 type GetPayloadRequest struct {
 	// PayloadID is the payload ID.
 	PayloadID PayloadID
 	// ForkVersion is the fork version that we are
 	// currently on.
 	ForkVersion uint32
+	// BuilderBoostFactor is the percentage multiplier applied to the
+	// builder's declared BlockValue before it is compared against the
+	// locally-built payload's value, as per the engine_getPayloadV3
+	// semantics: the builder payload wins when
+	// `builderValue * BuilderBoostFactor >= localValue * 100`. A value of
+	// 0 is treated as 100, i.e. a face-value comparison.
+	BuilderBoostFactor uint64
+	// RandaoReveal is the proposer's randao reveal for the slot this
+	// payload is being built for, forwarded so a remote builder can be
+	// asked to produce a payload without the caller having to track it
+	// separately.
+	RandaoReveal []byte
+	// UseBuilder indicates that, in addition to building locally, a bid
+	// should be requested from an external block builder and raced
+	// against the local payload by value.
+	UseBuilder bool
+	// BuilderPubkeys is the set of builder public keys, in preference
+	// order, that are allowed to supply a bid for this slot.
+	BuilderPubkeys [][]byte
 }
 
 // BuildGetPayloadRequest builds a get payload request.
 func BuildGetPayloadRequest(
 	payloadID PayloadID,
 	forkVersion uint32,
+	builderBoostFactor uint64,
+	randaoReveal []byte,
+	useBuilder bool,
+	builderPubkeys [][]byte,
 ) *GetPayloadRequest {
 	return &GetPayloadRequest{
-		PayloadID:   payloadID,
-		ForkVersion: forkVersion,
+		PayloadID:          payloadID,
+		ForkVersion:        forkVersion,
+		BuilderBoostFactor: builderBoostFactor,
+		RandaoReveal:       randaoReveal,
+		UseBuilder:         useBuilder,
+		BuilderPubkeys:     builderPubkeys,
 	}
-}
\ No newline at end of file
+}
+
+// GetPayloadResponse is the result of an engine_getPayloadV3/V4 call. The
+// builder may return either a full ExecutionPayload (with its accompanying
+// BlobsBundle) or, in the blinded-block flow, only an ExecutionPayloadHeader
+// referencing the withheld payload.
+//
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/cancun.md#engine_getpayloadv3
+//
+//nolint:lll
+type GetPayloadResponse struct {
+	// ExecutionPayload is the full payload, populated when the builder
+	// did not withhold it.
+	ExecutionPayload ExecutionPayload
+	// BlobsBundle holds the KZG commitments, proofs, and blobs produced
+	// alongside ExecutionPayload. It is empty for the blinded case.
+	BlobsBundle *BlobsBundle
+	// BlockValue is the value, in Wei, that the proposer would receive
+	// for including this payload.
+	BlockValue *big.Int
+	// ShouldOverrideBuilder signals that the local execution client has
+	// produced a payload that should be preferred over one from a
+	// remote builder, irrespective of BlockValue.
+	ShouldOverrideBuilder bool
+	// ExecutionPayloadHeader is populated instead of ExecutionPayload
+	// when the builder withheld the full payload (the blinded-block
+	// flow). Exactly one of ExecutionPayload or ExecutionPayloadHeader
+	// is expected to be non-empty.
+	ExecutionPayloadHeader ExecutionPayloadHeader
+	// ExecutionWitness is the Verkle state-diff and proof accompanying
+	// ExecutionPayload on an engine_getPayloadV4 response, from the
+	// Prague/Verkle fork onward. It is nil on every earlier fork and, when
+	// present, is forwarded back to the EL alongside ExecutionPayload on
+	// the subsequent engine_newPayloadV4 call.
+	ExecutionWitness *ExecutionWitness
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package engineprimitives
+
+import "errors"
+
+// ErrInvalidExecutionWitness is returned when an ExecutionWitness fails its
+// well-formedness checks.
+var ErrInvalidExecutionWitness = errors.New("invalid execution witness")
+
+// ErrExecutionWitnessForkMismatch is returned when a NewPayloadRequest's
+// ExecutionWitness presence does not match what IsVerkleEnabled expects for
+// its ForkVersion: present before the Verkle fork, or absent on/after it.
+var ErrExecutionWitnessForkMismatch = errors.New(
+	"execution witness presence does not match fork version",
+)
+
+// IPAProof is an Inner Product Argument proof over the Verkle commitment
+// scheme, as used to prove the StateDiff entries in an ExecutionWitness.
+//
+// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-6800.md
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path verkle.go -objs IPAProof,VerkleProof,SuffixStateDiff,StemStateDiff,ExecutionWitness -output verkle.ssz.go
+//
+//nolint:lll
+type IPAProof struct {
+	// CL is the left vector of commitments produced by the IPA folding
+	// rounds, one 32-byte commitment per round.
+	CL [][]byte `ssz-size:"?,32" ssz-max:"8"`
+	// CR is the right vector of commitments produced by the IPA folding
+	// rounds, one 32-byte commitment per round.
+	CR [][]byte `ssz-size:"?,32" ssz-max:"8"`
+	// FinalEvaluation is the scalar the polynomial evaluates to at the
+	// end of the IPA reduction.
+	FinalEvaluation []byte `ssz-size:"32"`
+}
+
+// VerkleProof is the Verkle multiproof accompanying an ExecutionWitness,
+// attesting to the pre-state values of every stem touched by the block.
+type VerkleProof struct {
+	// OtherStems is the set of stems touched by the proof that are not
+	// among the StateDiff entries themselves.
+	OtherStems [][]byte `ssz-size:"?,31" ssz-max:"65536"`
+	// DepthExtensionPresent indicates, per stem, the Verkle-tree depth at
+	// which the stem was found along with whether an extension was
+	// present at that depth.
+	DepthExtensionPresent []byte `ssz-max:"65536"`
+	// CommitmentsByPath is the list of internal node commitments along
+	// the path to each stem, ordered to match OtherStems.
+	CommitmentsByPath [][]byte `ssz-size:"?,32" ssz-max:"65536"`
+	// D is the commitment to the aggregated polynomial being opened.
+	D []byte `ssz-size:"32"`
+	// IPAProof is the proof that D opens to the claimed values.
+	IPAProof IPAProof
+}
+
+// SuffixStateDiff is the before/after values for a single suffix (byte
+// offset within a Verkle leaf) touched by the block.
+type SuffixStateDiff struct {
+	// Suffix is the byte offset within the stem's leaf node.
+	Suffix byte
+	// CurrentValue is the value at Suffix prior to block execution, or
+	// nil if the leaf was unset.
+	CurrentValue []byte `ssz-size:"32" ssz-optional:"true"`
+	// NewValue is the value at Suffix after block execution, or nil if
+	// the leaf was unset.
+	NewValue []byte `ssz-size:"32" ssz-optional:"true"`
+}
+
+// StemStateDiff is the set of suffix diffs sharing a common 31-byte Verkle
+// stem.
+type StemStateDiff struct {
+	// Stem is the 31-byte Verkle stem common to every SuffixDiff below.
+	Stem []byte `ssz-size:"31"`
+	// SuffixDiffs is the list of per-suffix value changes under Stem. A
+	// leaf node has 256 possible suffixes.
+	SuffixDiffs []SuffixStateDiff `ssz-max:"256"`
+}
+
+// ExecutionWitness accompanies an ExecutionPayload from the Prague/Verkle
+// fork onward, carrying the state diffs touched by block execution along
+// with a Verkle proof of their pre-state values so that a stateless client
+// can validate the block without holding the full state trie.
+//
+// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-6800.md
+//
+//nolint:lll
+type ExecutionWitness struct {
+	// StateDiff is the list of per-stem state changes made by the block.
+	StateDiff []StemStateDiff `ssz-max:"65536"`
+	// VerkleProof proves the pre-state values recorded in StateDiff.
+	VerkleProof VerkleProof
+}
+
+// Validate performs basic well-formedness checks on the ExecutionWitness:
+// that it is non-empty, that every stem is the expected length and has at
+// least one suffix diff, that DepthExtensionPresent has one entry per stem,
+// that CommitmentsByPath, D and FinalEvaluation are non-empty, and that CL
+// and CR have equal lengths. The length of CommitmentsByPath is not checked
+// against the number of stems. It does not verify the cryptographic
+// validity of the proof itself.
+func (w *ExecutionWitness) Validate() error {
+	if w == nil || len(w.StateDiff) == 0 {
+		return ErrInvalidExecutionWitness
+	}
+
+	for _, diff := range w.StateDiff {
+		if len(diff.Stem) != 31 { //nolint:mnd // verkle stems are 31 bytes.
+			return ErrInvalidExecutionWitness
+		}
+		if len(diff.SuffixDiffs) == 0 {
+			return ErrInvalidExecutionWitness
+		}
+	}
+
+	numStems := len(w.StateDiff) + len(w.VerkleProof.OtherStems)
+	if len(w.VerkleProof.DepthExtensionPresent) != numStems {
+		return ErrInvalidExecutionWitness
+	}
+	if len(w.VerkleProof.CommitmentsByPath) == 0 {
+		return ErrInvalidExecutionWitness
+	}
+	if len(w.VerkleProof.D) == 0 || len(w.VerkleProof.IPAProof.FinalEvaluation) == 0 {
+		return ErrInvalidExecutionWitness
+	}
+	if len(w.VerkleProof.IPAProof.CL) != len(w.VerkleProof.IPAProof.CR) {
+		return ErrInvalidExecutionWitness
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package engineprimitives_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+)
+
+func validWitness() *engineprimitives.ExecutionWitness {
+	return &engineprimitives.ExecutionWitness{
+		StateDiff: []engineprimitives.StemStateDiff{
+			{
+				Stem: make([]byte, 31),
+				SuffixDiffs: []engineprimitives.SuffixStateDiff{
+					{Suffix: 0, NewValue: make([]byte, 32)},
+				},
+			},
+		},
+		VerkleProof: engineprimitives.VerkleProof{
+			DepthExtensionPresent: []byte{0},
+			CommitmentsByPath:     [][]byte{make([]byte, 32)},
+			D:                     make([]byte, 32),
+			IPAProof: engineprimitives.IPAProof{
+				FinalEvaluation: make([]byte, 32),
+			},
+		},
+	}
+}
+
+func TestExecutionWitnessValidate(t *testing.T) {
+	if err := validWitness().Validate(); err != nil {
+		t.Fatalf("expected a well-formed witness to validate, got %v", err)
+	}
+
+	if err := (&engineprimitives.ExecutionWitness{}).Validate(); err == nil {
+		t.Fatal("expected an empty witness to fail validation")
+	}
+
+	shortStem := validWitness()
+	shortStem.StateDiff[0].Stem = make([]byte, 30)
+	if err := shortStem.Validate(); err == nil {
+		t.Fatal("expected a non-31-byte stem to fail validation")
+	}
+
+	mismatchedDepths := validWitness()
+	mismatchedDepths.VerkleProof.DepthExtensionPresent = nil
+	if err := mismatchedDepths.Validate(); err == nil {
+		t.Fatal("expected a DepthExtensionPresent length mismatch to fail validation")
+	}
+}
+
+func TestExecutionWitnessJSONRoundTrip(t *testing.T) {
+	want := validWitness()
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var got engineprimitives.ExecutionWitness
+	if err = json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if err = got.Validate(); err != nil {
+		t.Fatalf("round-tripped witness should still validate: %v", err)
+	}
+}
+
+func TestIsVerkleEnabled(t *testing.T) {
+	if engineprimitives.IsVerkleEnabled(engineprimitives.ForkVersionDeneb) {
+		t.Fatal("expected Deneb to not enable Verkle")
+	}
+	if !engineprimitives.IsVerkleEnabled(engineprimitives.ForkVersionElectra) {
+		t.Fatal("expected Electra to enable Verkle")
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (c) 2024 Berachain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+// WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR
+// OTHER DEALINGS IN THE SOFTWARE.
+
+package primitives
+
+// SigningData is the SSZ container every domain-separated signature in the
+// protocol signs over, per the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signingdata
+//
+//go:generate go run github.com/ferranbt/fastssz/sszgen -path signing.go -objs SigningData -output signing.ssz.go
+type SigningData struct {
+	// ObjectRoot is the hash tree root of the object being signed.
+	ObjectRoot Root `ssz-size:"32"`
+	// Domain is the domain the object is being signed under.
+	Domain Domain `ssz-size:"32"`
+}
+
+// ComputeSigningRoot computes the root that a signature over objectRoot is
+// expected to sign under domainType, as per the Ethereum 2.0
+// specification's compute_signing_root: the hash tree root of a
+// SigningData binding objectRoot to the domain ComputeDomain(domainType)
+// derives from fd.
+//
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+func ComputeSigningRoot(
+	fd *ForkData, domainType DomainType, objectRoot Root,
+) (Root, error) {
+	domain, err := fd.ComputeDomain(domainType)
+	if err != nil {
+		return Root{}, err
+	}
+
+	sd := SigningData{ObjectRoot: objectRoot, Domain: domain}
+	return sd.HashTreeRoot()
+}
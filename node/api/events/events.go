@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package events implements the beacon-node Server-Sent Events stream,
+// mirroring the topics exposed by the standard Beacon API's
+// /eth/v1/events endpoint.
+package events
+
+import (
+	"github.com/berachain/beacon-kit/primitives/common"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// Topic identifies an SSE event stream a client may subscribe to.
+type Topic string
+
+const (
+	// TopicBlockGossip carries the slot and root of a block. It is
+	// published together with TopicBlockReward, once the block has been
+	// processed through the state transition; it is not published
+	// earlier, when the block is first observed on the consensus layer.
+	TopicBlockGossip Topic = "block_gossip"
+	// TopicBlockReward fires once a block has been processed through the
+	// state transition, carrying the reward the proposer earned for it.
+	TopicBlockReward Topic = "block_reward"
+)
+
+// BlockGossipEvent is the payload of a TopicBlockGossip event.
+type BlockGossipEvent struct {
+	// Slot is the slot of the observed block.
+	Slot math.Slot `json:"slot"`
+	// Block is the hash tree root of the observed block.
+	Block common.Root `json:"block"`
+}
+
+// Topic returns TopicBlockGossip, satisfying the Event interface.
+func (BlockGossipEvent) Topic() Topic { return TopicBlockGossip }
+
+// StandardBlockReward is the reward breakdown for a single proposed block,
+// matching the shape of the standard Beacon API's block rewards response.
+type StandardBlockReward struct {
+	// ProposerIndex is the validator index that proposed the block.
+	ProposerIndex math.ValidatorIndex `json:"proposer_index"`
+	// Total is the sum of every component below.
+	Total math.Gwei `json:"total"`
+	// Attestations is the reward earned for attestations included in the
+	// block body.
+	Attestations math.Gwei `json:"attestations"`
+	// SyncAggregate is the reward earned for the sync committee
+	// aggregate included in the block body.
+	SyncAggregate math.Gwei `json:"sync_aggregate"`
+	// ProposerSlashings is the reward earned for proposer slashings
+	// included in the block body.
+	ProposerSlashings math.Gwei `json:"proposer_slashings"`
+	// AttesterSlashings is the reward earned for attester slashings
+	// included in the block body.
+	AttesterSlashings math.Gwei `json:"attester_slashings"`
+}
+
+// BlockRewardEvent is the payload of a TopicBlockReward event.
+type BlockRewardEvent struct {
+	StandardBlockReward
+}
+
+// Topic returns TopicBlockReward, satisfying the Event interface.
+func (BlockRewardEvent) Topic() Topic { return TopicBlockReward }
+
+// Event is implemented by every value publishable on the event stream.
+type Event interface {
+	Topic() Topic
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Queue is the subset of the node's dispatch queue abstraction that the
+// event stream needs: a way to hand off a value for asynchronous,
+// best-effort delivery to whatever is listening on the other end.
+type Queue interface {
+	Submit(ctx context.Context, item any) error
+}
+
+// GrandCentralDispatch is the dispatch-queue registry shared across the
+// node's subsystems, mirroring the interface of the same name in
+// beacon/execution.
+type GrandCentralDispatch interface {
+	// GetQueue returns a queue with the provided ID.
+	GetQueue(id string) Queue
+}
+
+// queueID is the dispatch-queue ID the event stream publishes onto.
+const queueID = "node-api-events"
+
+// Stream fans out Event values to subscribers, each filtered down to the
+// topics it asked for, mirroring the Beacon API's
+// /eth/v1/events?topics=... semantics.
+type Stream struct {
+	mu          sync.RWMutex
+	queue       Queue
+	subscribers map[chan Event]map[Topic]struct{}
+}
+
+// NewStream creates a new Stream that publishes onto the dispatcher's
+// node-api-events queue in addition to fanning out to local subscribers.
+func NewStream(dispatch GrandCentralDispatch) *Stream {
+	return &Stream{
+		queue:       dispatch.GetQueue(queueID),
+		subscribers: make(map[chan Event]map[Topic]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber for the given topics and returns a
+// channel of matching events along with an unsubscribe function. An empty
+// topics list subscribes to every topic.
+func (s *Stream) Subscribe(topics ...Topic) (<-chan Event, func()) {
+	filter := make(map[Topic]struct{}, len(topics))
+	for _, t := range topics {
+		filter[t] = struct{}{}
+	}
+
+	ch := make(chan Event, 1)
+	s.mu.Lock()
+	s.subscribers[ch] = filter
+	s.mu.Unlock()
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// Publish fans out ev to every subscriber whose filter matches its topic,
+// and additionally hands it to the dispatch queue for any non-local
+// listeners. Slow subscribers never block publication: an event that
+// cannot be enqueued without blocking is dropped for that subscriber.
+func (s *Stream) Publish(ctx context.Context, ev Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for ch, filter := range s.subscribers {
+		if len(filter) > 0 {
+			if _, ok := filter[ev.Topic()]; !ok {
+				continue
+			}
+		}
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+
+	if s.queue == nil {
+		return nil
+	}
+	return s.queue.Submit(ctx, ev)
+}
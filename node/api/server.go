@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package api aggregates the beacon node's HTTP-facing API surfaces onto a
+// single mux, mirroring how the standard Beacon API exposes its REST
+// endpoints and its /eth/v1/events SSE stream under one server.
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/lightclient"
+	"github.com/berachain/beacon-kit/node/api/events"
+)
+
+// Server serves stream's Server-Sent Events endpoint and, when pool is
+// non-nil, the light-client REST endpoints backed by pool.
+type Server struct {
+	httpServer *http.Server
+}
+
+// NewServer creates a Server listening on addr. pool may be nil, in which
+// case the light-client REST endpoints are not registered.
+func NewServer(
+	addr string, stream *events.Stream, pool *lightclient.Pool,
+) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/eth/v1/events", stream)
+	if pool != nil {
+		lightclient.RegisterHandlers(mux, pool)
+	}
+	return &Server{httpServer: &http.Server{Addr: addr, Handler: mux}}
+}
+
+// Start serves until ctx is cancelled or Stop is called, returning once
+// the underlying http.Server has shut down.
+func (s *Server) Start(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.httpServer.ListenAndServe() }()
+
+	select {
+	case <-ctx.Done():
+		return s.Stop(context.Background())
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Stop gracefully shuts down the HTTP server.
+func (s *Server) Stop(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
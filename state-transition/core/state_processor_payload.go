@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/builder"
+	engineprimitives "github.com/berachain/beacon-kit/mod/primitives-engine"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// payloadGetter is the subset of the engine client the block-production
+// path needs in order to retrieve a locally-built payload.
+type payloadGetter interface {
+	GetPayload(
+		ctx context.Context, req *engineprimitives.GetPayloadRequest,
+	) (*engineprimitives.GetPayloadResponse, error)
+}
+
+// ProduceExecutionPayload retrieves the locally-built payload for req via
+// engine, and when req.UseBuilder is set, races it against bids from
+// req.BuilderPubkeys using builder.RaceBids, returning whichever payload
+// is worth more once req.BuilderBoostFactor is applied. No bids are
+// requested when the local response sets ShouldOverrideBuilder. All
+// engine_getPayloadV3 weighted-bid comparisons happen inside
+// builder.PrefersBuilder; this method does not duplicate that logic.
+//
+// This mirrors the block-production step of the state-transition pipeline
+// without depending on BeaconStateT, so it takes engine and blder
+// directly rather than reading them off sp; it is a StateProcessor method
+// for consistency with this package's other per-block steps.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProduceExecutionPayload(
+	ctx context.Context,
+	engine payloadGetter,
+	blder builder.BlockBuilder,
+	req *engineprimitives.GetPayloadRequest,
+	slot uint64,
+	parentHash common.Hash,
+) (*engineprimitives.GetPayloadResponse, error) {
+	local, err := engine.GetPayload(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !req.UseBuilder || blder == nil || len(req.BuilderPubkeys) == 0 ||
+		local.ShouldOverrideBuilder {
+		return local, nil
+	}
+
+	bid, err := builder.RaceBids(
+		ctx, blder, slot, parentHash, req.BuilderPubkeys,
+		local.BlockValue, req.BuilderBoostFactor,
+	)
+	if err != nil || bid == nil {
+		// No usable bid beat the local payload: fall back to it, exactly
+		// as on a builder timeout or malformed response.
+		return local, nil
+	}
+
+	return &engineprimitives.GetPayloadResponse{
+		ExecutionPayloadHeader: bid.Header,
+		BlockValue:             bid.Value,
+	}, nil
+}
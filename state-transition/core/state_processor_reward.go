@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/node/api/events"
+	"github.com/berachain/beacon-kit/primitives/math"
+)
+
+// BlockRewardInputs carries the per-component rewards earned by a block, as
+// derived by the caller from the committee caches already built for this
+// slot. computeBlockReward does not rebuild those caches itself; it only
+// assembles the StandardBlockReward from values the caller already has on
+// hand.
+type BlockRewardInputs struct {
+	// Attestations is the reward earned for attestations included in the
+	// block body.
+	Attestations math.Gwei
+	// SyncAggregate is the reward earned for the sync committee aggregate
+	// included in the block body.
+	SyncAggregate math.Gwei
+	// ProposerSlashings is the reward earned for proposer slashings
+	// included in the block body.
+	ProposerSlashings math.Gwei
+	// AttesterSlashings is the reward earned for attester slashings
+	// included in the block body.
+	AttesterSlashings math.Gwei
+}
+
+// computeBlockReward derives the StandardBlockReward for blk from inputs,
+// the component rewards already computed by the caller from this slot's
+// committee caches, and total, their sum as already accounted for by the
+// caller.
+func (sp *StateProcessor[
+	BeaconBlockT, _, BeaconStateT,
+	ContextT, _, _, _, _, _, _, _, _, _, _,
+]) computeBlockReward(
+	blk BeaconBlockT,
+	total math.Gwei,
+	inputs BlockRewardInputs,
+) events.StandardBlockReward {
+	return events.StandardBlockReward{
+		ProposerIndex:     blk.GetProposerIndex(),
+		Total:             total,
+		Attestations:      inputs.Attestations,
+		SyncAggregate:     inputs.SyncAggregate,
+		ProposerSlashings: inputs.ProposerSlashings,
+		AttesterSlashings: inputs.AttesterSlashings,
+	}
+}
+
+// publishBlockEvents emits the block_gossip and block_reward SSE events for
+// a successfully processed block. A nil stream is a no-op, so callers that
+// run without the node-api events subsystem (e.g. tests) pay no cost.
+func (sp *StateProcessor[
+	BeaconBlockT, _, BeaconStateT,
+	ContextT, _, _, _, _, _, _, _, _, _, _,
+]) publishBlockEvents(
+	ctx ContextT,
+	blk BeaconBlockT,
+	reward events.StandardBlockReward,
+) error {
+	if sp.eventStream == nil {
+		return nil
+	}
+
+	root := blk.HashTreeRoot()
+	if err := sp.eventStream.Publish(ctx, events.BlockGossipEvent{
+		Slot:  blk.GetSlot(),
+		Block: root,
+	}); err != nil {
+		return err
+	}
+
+	return sp.eventStream.Publish(ctx, events.BlockRewardEvent{
+		StandardBlockReward: reward,
+	})
+}
+
+// ProcessBlockRewardEvents computes blk's StandardBlockReward from total
+// and inputs and publishes it, along with the block_gossip event, onto
+// sp.eventStream. Call this once blk has been fully applied to state, after
+// the committee caches inputs was derived from are up to date for the new
+// slot.
+//
+// Like processRandaoReveal, this is a per-block step invoked by the
+// top-level block-processing entry point, which this package does not
+// itself define.
+func (sp *StateProcessor[
+	BeaconBlockT, _, BeaconStateT,
+	ContextT, _, _, _, _, _, _, _, _, _, _,
+]) ProcessBlockRewardEvents(
+	ctx ContextT,
+	blk BeaconBlockT,
+	total math.Gwei,
+	inputs BlockRewardInputs,
+) error {
+	reward := sp.computeBlockReward(blk, total, inputs)
+	return sp.publishBlockEvents(ctx, blk, reward)
+}